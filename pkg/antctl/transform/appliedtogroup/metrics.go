@@ -0,0 +1,52 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appliedtogroup
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	cpv1beta "github.com/vmware-tanzu/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+var membershipSizeDesc = prometheus.NewDesc(
+	"antrea_appliedtogroup_members",
+	"Number of Pods currently selected by an AppliedToGroup.",
+	[]string{"name"}, nil,
+)
+
+// Lister returns the current set of AppliedToGroups, e.g. the controller's in-memory
+// AppliedToGroup store that also backs the antctl `get appliedtogroup` command.
+type Lister func() []cpv1beta.AppliedToGroup
+
+// MembershipSizeCollector is a prometheus.Collector that exports one gauge per AppliedToGroup,
+// set to its current membership size.
+type MembershipSizeCollector struct {
+	list Lister
+}
+
+// NewMembershipSizeCollector returns a MembershipSizeCollector backed by list.
+func NewMembershipSizeCollector(list Lister) *MembershipSizeCollector {
+	return &MembershipSizeCollector{list: list}
+}
+
+func (c *MembershipSizeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- membershipSizeDesc
+}
+
+func (c *MembershipSizeCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, group := range c.list() {
+		ch <- prometheus.MustNewConstMetric(membershipSizeDesc, prometheus.GaugeValue, float64(len(group.GroupMembers)), group.GetName())
+	}
+}
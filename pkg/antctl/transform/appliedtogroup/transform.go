@@ -15,12 +15,14 @@
 package appliedtogroup
 
 import (
+	"context"
 	"io"
 	"reflect"
 
 	"github.com/vmware-tanzu/antrea/pkg/antctl/transform"
 	"github.com/vmware-tanzu/antrea/pkg/antctl/transform/common"
 	cpv1beta "github.com/vmware-tanzu/antrea/pkg/apis/controlplane/v1beta2"
+	"github.com/vmware-tanzu/antrea/pkg/log"
 )
 
 type Response struct {
@@ -47,13 +49,29 @@ func objectTransform(o interface{}) (interface{}, error) {
 	return Response{Name: group.GetName(), Pods: pods}, nil
 }
 
-func Transform(reader io.Reader, single bool) (interface{}, error) {
-	return transform.GenericFactory(
+// Transform converts the AppliedToGroup(s) read from reader into Response(s). ctx carries the
+// logger used to emit one trace event per transformed object, tagged with the appliedToGroup name.
+func Transform(ctx context.Context, reader io.Reader, single bool) (interface{}, error) {
+	l := log.New(ctx, "antctl.transform.appliedtogroup")
+	result, err := transform.GenericFactory(
 		reflect.TypeOf(cpv1beta.AppliedToGroup{}),
 		reflect.TypeOf(cpv1beta.AppliedToGroupList{}),
 		objectTransform,
 		listTransform,
 	)(reader, single)
+	if err != nil {
+		l.Errorw("Failed to transform AppliedToGroup", "error", err)
+		return nil, err
+	}
+	switch r := result.(type) {
+	case Response:
+		l.Debugw("Transformed AppliedToGroup", "appliedToGroup", r.Name)
+	case []Response:
+		for _, o := range r {
+			l.Debugw("Transformed AppliedToGroup", "appliedToGroup", o.Name)
+		}
+	}
+	return result, nil
 }
 
 var _ common.TableOutput = new(Response)
@@ -0,0 +1,58 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta2
+
+// EnforcementConfig is the `enforcement:` block of the antrea-controller ConfigMap. It supplies
+// the cluster-wide default applied to rules that don't set EnforcementScopes themselves.
+type EnforcementConfig struct {
+	DefaultEnforcementScopes []EnforcementScope `yaml:"defaultEnforcementScopes,omitempty"`
+}
+
+// EffectiveEnforcementScopes returns the EnforcementScopes that apply to rule: the rule's own
+// scopes if it set any, otherwise cfg's cluster-wide default.
+func EffectiveEnforcementScopes(rule NetworkPolicyRule, cfg EnforcementConfig) []EnforcementScope {
+	if len(rule.EnforcementScopes) > 0 {
+		return rule.EnforcementScopes
+	}
+	return cfg.DefaultEnforcementScopes
+}
+
+func hasScope(scopes []EnforcementScope, scope EnforcementScope) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Enforces reports whether scopes include ScopeEnforce, i.e. whether matching traffic should
+// actually be dropped or rejected rather than only observed.
+func Enforces(scopes []EnforcementScope) bool {
+	return hasScope(scopes, ScopeEnforce)
+}
+
+// Audits reports whether scopes include ScopeAudit, i.e. whether matching sessions should be
+// logged and counted in AntreaNetworkPolicyStats.AuditedSessions without being enforced.
+func Audits(scopes []EnforcementScope) bool {
+	return hasScope(scopes, ScopeAudit)
+}
+
+// DryRuns reports whether scopes include ScopeDryRun, i.e. whether the rule should be evaluated
+// with neither logging nor enforcement, purely so its hit count can be compared against
+// AntreaNetworkPolicyStats once enforcement is turned on.
+func DryRuns(scopes []EnforcementScope) bool {
+	return hasScope(scopes, ScopeDryRun)
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveEnforcementScopes(t *testing.T) {
+	cfg := EnforcementConfig{DefaultEnforcementScopes: []EnforcementScope{ScopeEnforce}}
+
+	ruleWithScopes := NetworkPolicyRule{EnforcementScopes: []EnforcementScope{ScopeAudit, ScopeDryRun}}
+	assert.Equal(t, []EnforcementScope{ScopeAudit, ScopeDryRun}, EffectiveEnforcementScopes(ruleWithScopes, cfg))
+
+	ruleWithoutScopes := NetworkPolicyRule{}
+	assert.Equal(t, []EnforcementScope{ScopeEnforce}, EffectiveEnforcementScopes(ruleWithoutScopes, cfg))
+}
+
+func TestScopePredicates(t *testing.T) {
+	scopes := []EnforcementScope{ScopeAudit, ScopeDryRun}
+	assert.False(t, Enforces(scopes))
+	assert.True(t, Audits(scopes))
+	assert.True(t, DryRuns(scopes))
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AppliedToGroup describes a set of Pods to which a policy is applied.
+type AppliedToGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// GroupMembers has members of this group.
+	GroupMembers []GroupMember `json:"groupMembers,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AppliedToGroupList is a list of AppliedToGroup objects.
+type AppliedToGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AppliedToGroup `json:"items"`
+}
+
+// GroupMember represents a Pod that belongs to a group. It has no field for an external endpoint.
+type GroupMember struct {
+	Pod *PodReference `json:"pod,omitempty"`
+}
+
+// PodReference uniquely identifies a Pod.
+type PodReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// RuleAction describes the action to be applied on traffic matching a NetworkPolicyRule.
+type RuleAction string
+
+const (
+	RuleActionAllow  RuleAction = "Allow"
+	RuleActionDrop   RuleAction = "Drop"
+	RuleActionReject RuleAction = "Reject"
+)
+
+// EnforcementScope narrows where a RuleAction is actually applied in the datapath, allowing an
+// operator to observe the effect of a rule before it is fully enforced.
+type EnforcementScope string
+
+const (
+	// ScopeEnforce installs the drop/reject flows for the rule, as today.
+	ScopeEnforce EnforcementScope = "enforce"
+	// ScopeAudit evaluates the rule, logs matching sessions and counts them in
+	// AntreaNetworkPolicyStats.AuditedSessions, but does not drop or reject traffic.
+	ScopeAudit EnforcementScope = "audit"
+	// ScopeDryRun evaluates the rule without logging or dropping traffic, so its hit count can
+	// be compared against AntreaNetworkPolicyStats once enforcement is turned on.
+	ScopeDryRun EnforcementScope = "dryrun"
+)
+
+// NetworkPolicyRule describes a particular rule to be applied to the ingress or egress traffic of
+// Pods selected by a NetworkPolicy.
+type NetworkPolicyRule struct {
+	// Action specifies the action to be applied on the rule.
+	Action *RuleAction `json:"action,omitempty"`
+	// EnforcementScopes restricts which datapath effect of Action actually applies. If empty,
+	// the cluster-wide default enforcement scope configured on antrea-controller is used.
+	EnforcementScopes []EnforcementScope `json:"enforcementScopes,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkPolicy is the internal controlplane representation of an AntreaNetworkPolicy or
+// ClusterNetworkPolicy, computed by antrea-controller and realized onto each Node by the agent's
+// policy realizer.
+type NetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules is the list of ingress/egress rules this NetworkPolicy enforces.
+	Rules []NetworkPolicyRule `json:"rules,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkPolicyList is a list of NetworkPolicy objects.
+type NetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NetworkPolicy `json:"items"`
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AntreaNetworkPolicyStats is the statistics of a NetworkPolicy.
+type AntreaNetworkPolicyStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The traffic stats of the NetworkPolicy.
+	TrafficStats TrafficStats `json:"trafficStats,omitempty"`
+}
+
+// TrafficStats contains the traffic stats of a NetworkPolicy or a rule of a NetworkPolicy.
+type TrafficStats struct {
+	// Sessions is the number of sessions that hit the NetworkPolicy or the rule and were enforced
+	// (i.e. dropped or rejected).
+	Sessions int64 `json:"sessions"`
+	// Packets is the number of packets that hit the NetworkPolicy or the rule and were enforced.
+	Packets int64 `json:"packets"`
+	// Bytes is the number of bytes that hit the NetworkPolicy or the rule and were enforced.
+	Bytes int64 `json:"bytes"`
+	// AuditedSessions is the number of sessions that hit a rule scoped to "audit" and were
+	// logged and counted, but neither dropped nor rejected.
+	AuditedSessions int64 `json:"auditedSessions"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AntreaNetworkPolicyStatsList is a list of AntreaNetworkPolicyStats.
+type AntreaNetworkPolicyStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AntreaNetworkPolicyStats `json:"items"`
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// InstallMetricsHandler registers collectors (the antreanetworkpolicystats MetricsCollector and the
+// appliedtogroup MembershipSizeCollector) with a dedicated Prometheus registry and wires it to
+// "/metrics" on mux, so scraping antrea-controller's aggregated API server returns both alongside
+// the existing antreanetworkpolicystats and appliedtogroup REST resources.
+//
+// Per-collector filtering, such as the antreanetworkpolicystats namespace allow-list, is
+// configured on the collector before it is passed in here: build it with
+// antreanetworkpolicystats.NewMetricsCollector(provider, allowList).
+func InstallMetricsHandler(mux *http.ServeMux, collectors ...prometheus.Collector) error {
+	registry := prometheus.NewRegistry()
+	for _, c := range collectors {
+		if err := registry.Register(c); err != nil {
+			return err
+		}
+	}
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	return nil
+}
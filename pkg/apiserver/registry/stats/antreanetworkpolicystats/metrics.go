@@ -0,0 +1,117 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package antreanetworkpolicystats
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Metric descriptors shared by every statsProvider.Collect implementation, so the labels exported
+// on antrea-controller's /metrics endpoint stay stable regardless of which in-memory source backs
+// them.
+var (
+	SessionsDesc = prometheus.NewDesc(
+		"antrea_networkpolicy_sessions_total",
+		"Number of sessions enforced (dropped or rejected) by an Antrea NetworkPolicy rule.",
+		[]string{"namespace", "policy"}, nil,
+	)
+	AuditedSessionsDesc = prometheus.NewDesc(
+		"antrea_networkpolicy_audited_sessions_total",
+		"Number of sessions matched by an Antrea NetworkPolicy rule scoped to audit, but not enforced.",
+		[]string{"namespace", "policy"}, nil,
+	)
+	PacketsDesc = prometheus.NewDesc(
+		"antrea_networkpolicy_packets_total",
+		"Number of packets enforced by an Antrea NetworkPolicy rule.",
+		[]string{"namespace", "policy"}, nil,
+	)
+	BytesDesc = prometheus.NewDesc(
+		"antrea_networkpolicy_bytes_total",
+		"Number of bytes enforced by an Antrea NetworkPolicy rule.",
+		[]string{"namespace", "policy"}, nil,
+	)
+)
+
+// NamespaceAllowed reports whether metrics for namespace should be exported, bounding label
+// cardinality to the configured allow-list. An empty allow-list allows every namespace.
+func NamespaceAllowed(allowList []string, namespace string) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+	for _, ns := range allowList {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricsCollector adapts a statsProvider to prometheus.Collector, so the same in-memory source
+// that backs the antreanetworkpolicystats REST list/get path can be registered directly on
+// antrea-controller's /metrics endpoint. allowList bounds the "namespace" label cardinality the
+// endpoint exposes: metrics for any namespace not in allowList are dropped before reaching the
+// scrape channel.
+type MetricsCollector struct {
+	provider  statsProvider
+	allowList []string
+}
+
+// NewMetricsCollector returns a prometheus.Collector backed by provider, exporting metrics only
+// for namespaces in allowList (every namespace, if allowList is empty). provider is typically the
+// same value passed to NewREST, so the REST and scrape paths never disagree on a policy's
+// counters.
+func NewMetricsCollector(provider statsProvider, allowList []string) *MetricsCollector {
+	return &MetricsCollector{provider: provider, allowList: allowList}
+}
+
+func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// Collect forwards every metric provider.Collect emits for an allowed namespace, and drops the
+// rest, so allowList actually bounds label cardinality instead of just being computed and ignored.
+func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	if len(c.allowList) == 0 {
+		c.provider.Collect(ch)
+		return
+	}
+	unfiltered := make(chan prometheus.Metric)
+	go func() {
+		c.provider.Collect(unfiltered)
+		close(unfiltered)
+	}()
+	for m := range unfiltered {
+		if c.namespaceAllowed(m) {
+			ch <- m
+		}
+	}
+}
+
+// namespaceAllowed reports whether m carries a "namespace" label present in c.allowList. It reads
+// the label the same way the Prometheus registry itself does before exposition, by writing m to a
+// dto.Metric, since prometheus.Metric does not expose its labels directly.
+func (c *MetricsCollector) namespaceAllowed(m prometheus.Metric) bool {
+	var pb dto.Metric
+	if err := m.Write(&pb); err != nil {
+		return false
+	}
+	for _, label := range pb.GetLabel() {
+		if label.GetName() == "namespace" {
+			return NamespaceAllowed(c.allowList, label.GetValue())
+		}
+	}
+	return NamespaceAllowed(c.allowList, "")
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package antreanetworkpolicystats
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	statsv1alpha1 "github.com/vmware-tanzu/antrea/pkg/apis/stats/v1alpha1"
+	"github.com/vmware-tanzu/antrea/pkg/eventing"
+	"github.com/vmware-tanzu/antrea/pkg/features"
+	"github.com/vmware-tanzu/antrea/pkg/log"
+)
+
+// statsProvider is the interface that is used by REST to retrieve the AntreaNetworkPolicyStats.
+// It is implemented by the stats aggregation code running in antrea-controller, which keeps an
+// in-memory snapshot that backs both the REST list/get path and the Prometheus scrape path, so the
+// two never disagree on a given policy's counters.
+type statsProvider interface {
+	ListAntreaNetworkPolicyStats(namespace string) []statsv1alpha1.AntreaNetworkPolicyStats
+	GetAntreaNetworkPolicyStats(namespace, name string) (*statsv1alpha1.AntreaNetworkPolicyStats, bool)
+	// Collect reports every tracked AntreaNetworkPolicyStats as Prometheus metrics, so
+	// antrea-controller's /metrics endpoint can register it directly as a prometheus.Collector.
+	Collect(ch chan<- prometheus.Metric)
+}
+
+var (
+	_ rest.Storage = &REST{}
+	_ rest.Scoper  = &REST{}
+	_ rest.Getter  = &REST{}
+	_ rest.Lister  = &REST{}
+)
+
+// REST implements the REST endpoint for the antreanetworkpolicystats API.
+type REST struct {
+	statsProvider statsProvider
+
+	emitter *eventing.Emitter
+	// mu guards lastSeen, the last observed snapshot of each policy's counters, used to compute
+	// the delta pushed to emitter on every counter increase.
+	mu       sync.Mutex
+	lastSeen map[string]statsv1alpha1.TrafficStats
+}
+
+// NewREST returns a REST object that will work against API services. Events are dropped unless
+// WithEmitter is used.
+func NewREST(statsProvider statsProvider) *REST {
+	return &REST{
+		statsProvider: statsProvider,
+		emitter:       eventing.NewNoopEmitter(),
+		lastSeen:      map[string]statsv1alpha1.TrafficStats{},
+	}
+}
+
+// WithEmitter configures REST to hand every counter increase observed by List to emitter, which
+// sends a CloudEvent only for increases that reach its threshold.
+func (r *REST) WithEmitter(emitter *eventing.Emitter) *REST {
+	r.emitter = emitter
+	return r
+}
+
+func (r *REST) New() runtime.Object {
+	return &statsv1alpha1.AntreaNetworkPolicyStats{}
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &statsv1alpha1.AntreaNetworkPolicyStatsList{}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+// Get retrieves the AntreaNetworkPolicyStats for the given NetworkPolicy.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+	l := log.New(ctx, "apiserver.stats.antreanetworkpolicystats").With("namespace", ns, "policy", name)
+	if err := r.checkFeatureGates(l); err != nil {
+		return nil, err
+	}
+	stats, exists := r.statsProvider.GetAntreaNetworkPolicyStats(ns, name)
+	if !exists {
+		l.Debugw("AntreaNetworkPolicyStats not found")
+		return nil, errors.NewNotFound(statsv1alpha1.Resource("antreanetworkpolicystats"), name)
+	}
+	return stats, nil
+}
+
+// List retrieves the AntreaNetworkPolicyStats for the NetworkPolicies in the given Namespace, or
+// in all Namespaces if no Namespace is set on the context.
+func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+	l := log.New(ctx, "apiserver.stats.antreanetworkpolicystats").With("namespace", ns)
+	if err := r.checkFeatureGates(l); err != nil {
+		return nil, err
+	}
+	items := r.statsProvider.ListAntreaNetworkPolicyStats(ns)
+	if items == nil {
+		items = []statsv1alpha1.AntreaNetworkPolicyStats{}
+	}
+	l.Debugw("Listed AntreaNetworkPolicyStats", "count", len(items))
+	r.emitDeltas(ctx, items)
+	return &statsv1alpha1.AntreaNetworkPolicyStatsList{Items: items}, nil
+}
+
+// emitDeltas passes to r.emitter a delta for every policy whose counters advanced since the last
+// List that returned it, computed by diffing against the snapshot cached in r.lastSeen. Get does
+// not update r.lastSeen.
+func (r *REST) emitDeltas(ctx context.Context, items []statsv1alpha1.AntreaNetworkPolicyStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lastSeen == nil {
+		r.lastSeen = map[string]statsv1alpha1.TrafficStats{}
+	}
+	for _, item := range items {
+		key := item.Namespace + "/" + item.Name
+		prev := r.lastSeen[key]
+		sessionsDelta := item.TrafficStats.Sessions - prev.Sessions
+		auditedDelta := item.TrafficStats.AuditedSessions - prev.AuditedSessions
+		r.lastSeen[key] = item.TrafficStats
+		if sessionsDelta <= 0 && auditedDelta <= 0 {
+			continue
+		}
+		if err := r.emitter.EmitNetworkPolicyStatsDelta(ctx, eventing.StatsDelta{
+			Namespace:            item.Namespace,
+			Name:                 item.Name,
+			SessionsDelta:        sessionsDelta,
+			AuditedSessionsDelta: auditedDelta,
+		}); err != nil {
+			log.New(ctx, "apiserver.stats.antreanetworkpolicystats").Warnw("Failed to emit stats delta event", "policy", key, "error", err)
+		}
+	}
+}
+
+func (r *REST) checkFeatureGates(l *log.Logger) error {
+	npStatsEnabled := features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats)
+	antreaPolicyEnabled := features.DefaultFeatureGate.Enabled(features.AntreaPolicy)
+	l.Debugw("Checked feature gates", "networkPolicyStats", npStatsEnabled, "antreaPolicy", antreaPolicyEnabled)
+	if !npStatsEnabled {
+		return errors.NewServiceUnavailable(fmt.Sprintf("feature gate %s disabled", features.NetworkPolicyStats))
+	}
+	if !antreaPolicyEnabled {
+		return errors.NewServiceUnavailable(fmt.Sprintf("feature gate %s disabled", features.AntreaPolicy))
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
@@ -59,6 +60,17 @@ func (p *fakeStatsProvider) GetAntreaNetworkPolicyStats(namespace, name string)
 	return &m, true
 }
 
+func (p *fakeStatsProvider) Collect(ch chan<- prometheus.Metric) {
+	for namespace, byName := range p.stats {
+		for name, stats := range byName {
+			ch <- prometheus.MustNewConstMetric(SessionsDesc, prometheus.CounterValue, float64(stats.TrafficStats.Sessions), namespace, name)
+			ch <- prometheus.MustNewConstMetric(AuditedSessionsDesc, prometheus.CounterValue, float64(stats.TrafficStats.AuditedSessions), namespace, name)
+			ch <- prometheus.MustNewConstMetric(PacketsDesc, prometheus.CounterValue, float64(stats.TrafficStats.Packets), namespace, name)
+			ch <- prometheus.MustNewConstMetric(BytesDesc, prometheus.CounterValue, float64(stats.TrafficStats.Bytes), namespace, name)
+		}
+	}
+}
+
 func TestRESTGet(t *testing.T) {
 	tests := []struct {
 		name                      string
@@ -111,6 +123,10 @@ func TestRESTGet(t *testing.T) {
 							Namespace: "foo",
 							Name:      "bar",
 						},
+						TrafficStats: statsv1alpha1.TrafficStats{
+							Sessions:        10,
+							AuditedSessions: 3,
+						},
 					},
 				},
 			},
@@ -121,6 +137,10 @@ func TestRESTGet(t *testing.T) {
 					Namespace: "foo",
 					Name:      "bar",
 				},
+				TrafficStats: statsv1alpha1.TrafficStats{
+					Sessions:        10,
+					AuditedSessions: 3,
+				},
 			},
 			expectedErr: false,
 		},
@@ -278,3 +298,96 @@ func TestRESTList(t *testing.T) {
 		})
 	}
 }
+
+func TestCollect(t *testing.T) {
+	p := &fakeStatsProvider{
+		stats: map[string]map[string]statsv1alpha1.AntreaNetworkPolicyStats{
+			"foo": {
+				"bar": {
+					TrafficStats: statsv1alpha1.TrafficStats{Sessions: 5, AuditedSessions: 2, Packets: 100, Bytes: 1500},
+				},
+			},
+		},
+	}
+	ch := make(chan prometheus.Metric, 4)
+	p.Collect(ch)
+	close(ch)
+	var got int
+	for range ch {
+		got++
+	}
+	assert.Equal(t, 4, got)
+}
+
+func TestNamespaceAllowed(t *testing.T) {
+	assert.True(t, NamespaceAllowed(nil, "foo"))
+	assert.True(t, NamespaceAllowed([]string{"foo", "bar"}, "foo"))
+	assert.False(t, NamespaceAllowed([]string{"bar"}, "foo"))
+}
+
+func TestMetricsCollectorRegistersAndGathers(t *testing.T) {
+	p := &fakeStatsProvider{
+		stats: map[string]map[string]statsv1alpha1.AntreaNetworkPolicyStats{
+			"foo": {
+				"bar": {
+					TrafficStats: statsv1alpha1.TrafficStats{Sessions: 5, AuditedSessions: 2, Packets: 100, Bytes: 1500},
+				},
+			},
+		},
+	}
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(NewMetricsCollector(p, nil)))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	var gotSessions, gotPackets, gotBytes bool
+	for _, f := range families {
+		switch f.GetName() {
+		case "antrea_networkpolicy_sessions_total":
+			gotSessions = true
+		case "antrea_networkpolicy_packets_total":
+			gotPackets = true
+		case "antrea_networkpolicy_bytes_total":
+			gotBytes = true
+		}
+	}
+	assert.True(t, gotSessions, "expected antrea_networkpolicy_sessions_total to be gathered")
+	assert.True(t, gotPackets, "expected antrea_networkpolicy_packets_total to be gathered")
+	assert.True(t, gotBytes, "expected antrea_networkpolicy_bytes_total to be gathered")
+}
+
+func TestMetricsCollectorDropsDisallowedNamespace(t *testing.T) {
+	p := &fakeStatsProvider{
+		stats: map[string]map[string]statsv1alpha1.AntreaNetworkPolicyStats{
+			"allowed": {
+				"bar": {
+					TrafficStats: statsv1alpha1.TrafficStats{Sessions: 5},
+				},
+			},
+			"blocked": {
+				"baz": {
+					TrafficStats: statsv1alpha1.TrafficStats{Sessions: 9},
+				},
+			},
+		},
+	}
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(NewMetricsCollector(p, []string{"allowed"})))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	var namespaces []string
+	for _, f := range families {
+		if f.GetName() != "antrea_networkpolicy_sessions_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "namespace" {
+					namespaces = append(namespaces, l.GetValue())
+				}
+			}
+		}
+	}
+	assert.Equal(t, []string{"allowed"}, namespaces, "blocked namespace's metrics must be dropped, not just the allow-list check")
+}
@@ -0,0 +1,150 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventing emits CloudEvents (v1.0) whenever AppliedToGroup membership changes, an
+// AntreaNetworkPolicy is realized on a node, or AntreaNetworkPolicyStats counters advance beyond a
+// configurable threshold. It lets downstream SIEM/audit pipelines react to policy activity in
+// near-real-time instead of polling the antreanetworkpolicystats API.
+package eventing
+
+import (
+	"context"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+const (
+	// SourceAntreaController identifies events emitted by antrea-controller.
+	SourceAntreaController = "antrea-controller"
+
+	TypeAppliedToGroupChanged   = "tanzu.vmware.antrea.appliedtogroup.changed"
+	TypeNetworkPolicyRealized   = "tanzu.vmware.antrea.networkpolicy.realized"
+	TypeNetworkPolicyStatsDelta = "tanzu.vmware.antrea.networkpolicystats.delta"
+)
+
+// Sink delivers a single CloudEvent to a downstream collector. Implementations must be safe for
+// concurrent use.
+type Sink interface {
+	Send(ctx context.Context, event cloudevents.Event) error
+}
+
+// Emitter builds antrea-specific CloudEvents and hands them to a Sink. A nil Emitter (or one built
+// with NewNoopEmitter) drops every event, so callers do not need to nil-check it when eventing is
+// left unconfigured.
+type Emitter struct {
+	sink Sink
+	// threshold is the minimum counter increase EmitNetworkPolicyStatsDelta requires before it
+	// will emit a TypeNetworkPolicyStatsDelta event. Values below 1 are treated as 1, so the
+	// zero value still suppresses no-op (zero-delta) events.
+	threshold int64
+}
+
+// NewEmitter returns an Emitter that publishes through sink, firing a TypeNetworkPolicyStatsDelta
+// event only when a delta's Sessions or AuditedSessions counter advances by at least threshold.
+func NewEmitter(sink Sink, threshold int64) *Emitter {
+	return &Emitter{sink: sink, threshold: threshold}
+}
+
+// NewNoopEmitter returns an Emitter that drops every event. It is the default when the
+// `eventing:` block is absent from the antrea-controller ConfigMap.
+func NewNoopEmitter() *Emitter {
+	return &Emitter{sink: noopSink{}}
+}
+
+type noopSink struct{}
+
+func (noopSink) Send(context.Context, cloudevents.Event) error { return nil }
+
+func newEvent(eventType, subject string, data interface{}) (cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	event.SetSpecVersion(cloudevents.VersionV1)
+	event.SetSource(SourceAntreaController)
+	event.SetType(eventType)
+	event.SetSubject(subject)
+	event.SetTime(time.Now())
+	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return cloudevents.Event{}, err
+	}
+	return event, nil
+}
+
+// AppliedToGroupChangedData is the payload of a TypeAppliedToGroupChanged event.
+type AppliedToGroupChangedData struct {
+	Name          string `json:"name"`
+	MembersBefore int    `json:"membersBefore"`
+	MembersAfter  int    `json:"membersAfter"`
+}
+
+// EmitAppliedToGroupChanged emits a TypeAppliedToGroupChanged event. Errors are returned rather
+// than logged so callers can decide whether a delivery failure should affect their own success
+// path.
+func (e *Emitter) EmitAppliedToGroupChanged(ctx context.Context, data AppliedToGroupChangedData) error {
+	if e == nil {
+		return nil
+	}
+	event, err := newEvent(TypeAppliedToGroupChanged, data.Name, data)
+	if err != nil {
+		return err
+	}
+	return e.sink.Send(ctx, event)
+}
+
+// NetworkPolicyRealizedData is the payload of a TypeNetworkPolicyRealized event.
+type NetworkPolicyRealizedData struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Node      string `json:"node"`
+}
+
+// EmitNetworkPolicyRealized emits a TypeNetworkPolicyRealized event.
+func (e *Emitter) EmitNetworkPolicyRealized(ctx context.Context, data NetworkPolicyRealizedData) error {
+	if e == nil {
+		return nil
+	}
+	event, err := newEvent(TypeNetworkPolicyRealized, data.Namespace+"/"+data.Name, data)
+	if err != nil {
+		return err
+	}
+	return e.sink.Send(ctx, event)
+}
+
+// StatsDelta is the payload of a TypeNetworkPolicyStatsDelta event: the increase in each counter
+// observed since the last snapshot.
+type StatsDelta struct {
+	Namespace            string `json:"namespace"`
+	Name                 string `json:"name"`
+	SessionsDelta        int64  `json:"sessionsDelta"`
+	AuditedSessionsDelta int64  `json:"auditedSessionsDelta"`
+}
+
+// EmitNetworkPolicyStatsDelta emits a TypeNetworkPolicyStatsDelta event, unless neither counter in
+// data advanced by at least e.threshold, in which case it is dropped silently.
+func (e *Emitter) EmitNetworkPolicyStatsDelta(ctx context.Context, data StatsDelta) error {
+	if e == nil {
+		return nil
+	}
+	threshold := e.threshold
+	if threshold < 1 {
+		threshold = 1
+	}
+	if data.SessionsDelta < threshold && data.AuditedSessionsDelta < threshold {
+		return nil
+	}
+	event, err := newEvent(TypeNetworkPolicyStatsDelta, data.Namespace+"/"+data.Name, data)
+	if err != nil {
+		return err
+	}
+	return e.sink.Send(ctx, event)
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"context"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	types []string
+}
+
+func (s *recordingSink) Send(ctx context.Context, event cloudevents.Event) error {
+	s.types = append(s.types, event.Type())
+	return nil
+}
+
+func TestNewEmitterFromConfigNoop(t *testing.T) {
+	for _, cfg := range []*Config{nil, {}} {
+		e, err := NewEmitterFromConfig(context.Background(), cfg)
+		require.NoError(t, err)
+		require.NoError(t, e.EmitNetworkPolicyStatsDelta(context.Background(), StatsDelta{SessionsDelta: 100}))
+	}
+}
+
+func TestNewEmitterFromConfigUnknownType(t *testing.T) {
+	_, err := NewEmitterFromConfig(context.Background(), &Config{Type: "carrier-pigeon"})
+	assert.Error(t, err)
+}
+
+func TestEmitNetworkPolicyStatsDeltaThreshold(t *testing.T) {
+	sink := &recordingSink{}
+	e := NewEmitter(sink, 5)
+
+	require.NoError(t, e.EmitNetworkPolicyStatsDelta(context.Background(), StatsDelta{Namespace: "foo", Name: "bar", SessionsDelta: 3}))
+	assert.Empty(t, sink.types, "delta below threshold must not emit")
+
+	require.NoError(t, e.EmitNetworkPolicyStatsDelta(context.Background(), StatsDelta{Namespace: "foo", Name: "bar", AuditedSessionsDelta: 5}))
+	assert.Equal(t, []string{TypeNetworkPolicyStatsDelta}, sink.types, "delta at threshold must emit")
+}
+
+func TestEmitNetworkPolicyStatsDeltaDefaultThreshold(t *testing.T) {
+	sink := &recordingSink{}
+	e := NewEmitter(sink, 0)
+
+	require.NoError(t, e.EmitNetworkPolicyStatsDelta(context.Background(), StatsDelta{SessionsDelta: 0, AuditedSessionsDelta: 0}))
+	assert.Empty(t, sink.types, "zero delta must not emit even with threshold 0")
+
+	require.NoError(t, e.EmitNetworkPolicyStatsDelta(context.Background(), StatsDelta{SessionsDelta: 1}))
+	assert.Equal(t, []string{TypeNetworkPolicyStatsDelta}, sink.types, "any positive delta must emit when threshold is 0")
+}
+
+func TestNilEmitterDropsEvents(t *testing.T) {
+	var e *Emitter
+	require.NoError(t, e.EmitNetworkPolicyStatsDelta(context.Background(), StatsDelta{SessionsDelta: 1000}))
+}
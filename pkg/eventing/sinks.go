@@ -0,0 +1,131 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"context"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol/kafka_sarama"
+)
+
+// Config is the `eventing:` block of the antrea-controller ConfigMap.
+type Config struct {
+	// Type selects the Sink implementation: "http" or "kafka". Empty disables eventing.
+	Type string `yaml:"type,omitempty"`
+	// HTTP configures the HTTP sink; only read when Type is "http".
+	HTTP *HTTPSinkConfig `yaml:"http,omitempty"`
+	// Kafka configures the Kafka sink; only read when Type is "kafka".
+	Kafka *KafkaSinkConfig `yaml:"kafka,omitempty"`
+	// StatsDeltaThreshold is the minimum Sessions or AuditedSessions counter increase that
+	// triggers a TypeNetworkPolicyStatsDelta event. Zero (the default) emits on any positive
+	// increase.
+	StatsDeltaThreshold int64 `yaml:"statsDeltaThreshold,omitempty"`
+}
+
+// NewEmitterFromConfig builds the Emitter described by cfg, or a no-op Emitter if cfg is nil or
+// cfg.Type is empty.
+func NewEmitterFromConfig(ctx context.Context, cfg *Config) (*Emitter, error) {
+	if cfg == nil || cfg.Type == "" {
+		return NewNoopEmitter(), nil
+	}
+	switch cfg.Type {
+	case "http":
+		sink, err := NewHTTPSink(cfg.HTTP)
+		if err != nil {
+			return nil, err
+		}
+		return NewEmitter(sink, cfg.StatsDeltaThreshold), nil
+	case "kafka":
+		sink, err := NewKafkaSink(ctx, cfg.Kafka)
+		if err != nil {
+			return nil, err
+		}
+		return NewEmitter(sink, cfg.StatsDeltaThreshold), nil
+	default:
+		return nil, fmt.Errorf("unknown eventing sink type %q", cfg.Type)
+	}
+}
+
+// HTTPSinkConfig configures HTTPSink.
+type HTTPSinkConfig struct {
+	// Endpoint is the URL events are POSTed to.
+	Endpoint string `yaml:"endpoint"`
+}
+
+// HTTPSink sends CloudEvents as JSON over HTTP.
+type HTTPSink struct {
+	client cloudevents.Client
+	target string
+}
+
+// NewHTTPSink returns a Sink that POSTs events to cfg.Endpoint.
+func NewHTTPSink(cfg *HTTPSinkConfig) (*HTTPSink, error) {
+	if cfg == nil || cfg.Endpoint == "" {
+		return nil, fmt.Errorf("eventing: http sink requires an endpoint")
+	}
+	client, err := cloudevents.NewClientHTTP()
+	if err != nil {
+		return nil, fmt.Errorf("eventing: failed to create HTTP client: %w", err)
+	}
+	return &HTTPSink{client: client, target: cfg.Endpoint}, nil
+}
+
+func (s *HTTPSink) Send(ctx context.Context, event cloudevents.Event) error {
+	ctx = cloudevents.ContextWithTarget(ctx, s.target)
+	result := s.client.Send(ctx, event)
+	if cloudevents.IsUndelivered(result) {
+		return fmt.Errorf("eventing: failed to deliver event to %s: %w", s.target, result)
+	}
+	return nil
+}
+
+// KafkaSinkConfig configures KafkaSink.
+type KafkaSinkConfig struct {
+	// Brokers is the list of Kafka bootstrap brokers, e.g. "kafka:9092".
+	Brokers []string `yaml:"brokers"`
+	// Topic is the Kafka topic events are published to.
+	Topic string `yaml:"topic"`
+}
+
+// KafkaSink sends CloudEvents as JSON over Kafka.
+type KafkaSink struct {
+	client cloudevents.Client
+}
+
+// NewKafkaSink returns a Sink that publishes events to cfg.Topic on cfg.Brokers.
+func NewKafkaSink(ctx context.Context, cfg *KafkaSinkConfig) (*KafkaSink, error) {
+	if cfg == nil || len(cfg.Brokers) == 0 || cfg.Topic == "" {
+		return nil, fmt.Errorf("eventing: kafka sink requires brokers and a topic")
+	}
+	protocol, err := kafka_sarama.NewSender(cfg.Brokers, nil, cfg.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("eventing: failed to create Kafka sender: %w", err)
+	}
+	client, err := cloudevents.NewClient(protocol)
+	if err != nil {
+		return nil, fmt.Errorf("eventing: failed to create Kafka client: %w", err)
+	}
+	return &KafkaSink{client: client}, nil
+}
+
+func (s *KafkaSink) Send(ctx context.Context, event cloudevents.Event) error {
+	result := s.client.Send(ctx, event)
+	if cloudevents.IsUndelivered(result) {
+		return fmt.Errorf("eventing: failed to publish event to Kafka: %w", result)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// owner: @antrea-io
+	// alpha: v0.9
+	// Enables Antrea-native policies, allowing users to define network policies that are
+	// scoped to the whole cluster and express rules beyond what K8s NetworkPolicy allows.
+	AntreaPolicy featuregate.Feature = "AntreaPolicy"
+
+	// owner: @antrea-io
+	// alpha: v0.10
+	// Enables collecting and exposing NetworkPolicy statistics, including the
+	// antreanetworkpolicystats API.
+	NetworkPolicyStats featuregate.Feature = "NetworkPolicyStats"
+)
+
+// DefaultAntreaFeatureGates consists of all known Antrea feature keys. To add a new feature,
+// define a key for it and add it here.
+var DefaultAntreaFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	AntreaPolicy:       {Default: false, PreRelease: featuregate.Alpha},
+	NetworkPolicyStats: {Default: false, PreRelease: featuregate.Alpha},
+}
+
+// DefaultFeatureGate is the Antrea-wide feature gate shared by antrea-agent, antrea-controller
+// and antctl.
+var DefaultFeatureGate = featuregate.NewFeatureGate()
+
+func init() {
+	runtime.Must(DefaultFeatureGate.Add(DefaultAntreaFeatureGates))
+}
@@ -0,0 +1,125 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package log provides a structured, context-scoped logger built on zap. It is meant to
+// eventually replace the ad-hoc klog calls sprinkled across antrea-controller, antrea-agent and
+// antctl with JSON output that carries consistent fields (component, namespace, policy,
+// appliedToGroup, git_sha, correlation id) so log lines can be correlated and queried.
+//
+// Today it is wired through the antreanetworkpolicystats REST handlers and the antctl
+// appliedtogroup transform, the only transform package present in this tree; antctl's
+// addressgroup and networkpolicy transforms don't exist here yet, so they have nothing to wire.
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/vmware-tanzu/antrea/pkg/version"
+)
+
+// Format selects the encoding used by the process-wide logger. It implements pflag.Value so
+// antrea-controller, antrea-agent and antctl can bind it directly to a --log-format flag, e.g.
+// fs.Var(&format, "log-format", "Log encoding: json or text"). None of those commands' flag sets
+// exist in this tree yet, so nothing calls Set today; New/With already consume whatever format
+// SetFormat was last called with.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatText Format = "text"
+)
+
+// String implements pflag.Value.
+func (f *Format) String() string {
+	return string(*f)
+}
+
+// Set implements pflag.Value. It validates value, stores it, and reconfigures the process-wide
+// logger via SetFormat so the change takes effect immediately, matching how pflag calls Set as
+// soon as a flag is parsed.
+func (f *Format) Set(value string) error {
+	switch Format(value) {
+	case FormatJSON, FormatText:
+		*f = Format(value)
+		SetFormat(*f)
+		return nil
+	default:
+		return fmt.Errorf("invalid log format %q: must be %q or %q", value, FormatJSON, FormatText)
+	}
+}
+
+// Type implements pflag.Value.
+func (f *Format) Type() string {
+	return "format"
+}
+
+type correlationIDKey struct{}
+
+// Logger wraps a zap.SugaredLogger with the fields that should be present on every antrea log
+// entry.
+type Logger struct {
+	*zap.SugaredLogger
+}
+
+// base is the process-wide logger; New derives request/component scoped loggers from it.
+var base = newLogger(FormatText)
+
+func newLogger(format Format) *zap.Logger {
+	cfg := zap.NewProductionConfig()
+	if format == FormatText {
+		cfg.Encoding = "console"
+		cfg.EncoderConfig = zap.NewDevelopmentEncoderConfig()
+	} else {
+		cfg.Encoding = "json"
+		cfg.EncoderConfig.TimeKey = "ts"
+		cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	}
+	l, err := cfg.Build()
+	if err != nil {
+		// Logging setup must never be fatal; fall back to a no-op logger.
+		l = zap.NewNop()
+	}
+	return l.With(zap.String("git_sha", version.GetGitSHA()))
+}
+
+// SetFormat reconfigures the process-wide logger. It should be called once, early in main(), in
+// response to the --log-format flag.
+func SetFormat(format Format) {
+	base = newLogger(format)
+}
+
+// WithCorrelationID returns a copy of ctx that carries the given correlation ID. Loggers derived
+// from this ctx via New will include it as the "correlation_id" field.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// New returns a Logger scoped to component, with any correlation ID carried by ctx attached.
+func New(ctx context.Context, component string) *Logger {
+	fields := []zap.Field{zap.String("component", component)}
+	if id, ok := ctx.Value(correlationIDKey{}).(string); ok && id != "" {
+		fields = append(fields, zap.String("correlation_id", id))
+	}
+	return &Logger{base.With(fields...).Sugar()}
+}
+
+// With returns a child Logger with the given key/value pairs added, e.g. "namespace", ns,
+// "policy", name.
+func (l *Logger) With(keysAndValues ...interface{}) *Logger {
+	return &Logger{l.SugaredLogger.With(keysAndValues...)}
+}
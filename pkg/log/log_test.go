@@ -0,0 +1,57 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFormatSet(t *testing.T) {
+	defer SetFormat(FormatText)
+
+	var f Format
+	require.NoError(t, f.Set("json"))
+	assert.Equal(t, FormatJSON, f)
+	assert.Equal(t, "json", f.String())
+
+	require.NoError(t, f.Set("text"))
+	assert.Equal(t, FormatText, f)
+
+	assert.Error(t, f.Set("yaml"))
+}
+
+func TestNewAttachesCorrelationID(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	origBase := base
+	base = zap.New(core)
+	defer func() { base = origBase }()
+
+	ctx := WithCorrelationID(context.Background(), "req-1")
+	l := New(ctx, "log_test")
+	require.NotNil(t, l)
+	// The correlation ID attached by New must survive deriving a child logger with more fields.
+	l.With("key", "value").Debugw("test")
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "req-1", entries[0].ContextMap()["correlation_id"])
+}
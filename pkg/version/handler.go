@@ -0,0 +1,31 @@
+// Copyright 2020 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ServeHTTP writes the running binary's Info as JSON, in the same shape as "kubectl version".
+// antrea-controller's aggregated API server registers this at "/version" so tooling can discover
+// a peer's capabilities (via MinCompatibleAPIVersion) before issuing requests.
+func ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	// Encoding errors here would mean Info itself is not JSON-serializable, which is a
+	// programmer error rather than a request-time failure, so it is not worth surfacing to the
+	// client as a 5xx.
+	_ = json.NewEncoder(w).Encode(GetInfo())
+}
@@ -6,6 +6,8 @@ package version
 import (
 	"fmt"
 	"runtime"
+
+	kversion "k8s.io/apimachinery/pkg/version"
 )
 
 // These variables are set at build-time.
@@ -20,8 +22,64 @@ var (
 	// Can be "unreleased" or "released"; if it is "unreleased" then we add build information to
 	// the version in GetFullVersion
 	ReleaseStatus = "unreleased"
+	// BuildDate is the RFC3339 timestamp of the build, set by the release tooling.
+	BuildDate = ""
+)
+
+// GoVersion and Compiler are not build-time variables: they describe the toolchain that produced
+// the running binary, so they are always accurate even for a binary built outside the release
+// pipeline (e.g. "go build" during development).
+var (
+	GoVersion = runtime.Version()
+	Compiler  = runtime.Compiler
 )
 
+// MinCompatibleAPIVersion maps a controlplane API group to the oldest version of that group this
+// binary can still speak, e.g. "controlplane.antrea.tanzu.vmware.com": "v1beta1". It is consulted
+// by Negotiate, together with MaxCompatibleAPIVersion, so that agent<->controller RPCs and antctl
+// do not have to hard-code a single controlplane version the way the appliedtogroup transform does
+// today.
+var MinCompatibleAPIVersion = map[string]string{
+	"controlplane.antrea.tanzu.vmware.com": "v1beta1",
+}
+
+// MaxCompatibleAPIVersion maps a controlplane API group to the newest version of that group this
+// binary can speak. Negotiate only ever picks a version within [Min, Max] on both sides, so it
+// never returns a version either peer doesn't actually implement.
+var MaxCompatibleAPIVersion = map[string]string{
+	"controlplane.antrea.tanzu.vmware.com": "v1beta2",
+}
+
+// Info is the full set of version and build metadata for a running antrea-agent,
+// antrea-controller or antctl binary. It is served verbatim by the aggregated API server's
+// /version endpoint, in the same JSON shape as "kubectl version".
+type Info struct {
+	Version                 string            `json:"version"`
+	GitSHA                  string            `json:"gitSHA"`
+	GitTreeState            string            `json:"gitTreeState"`
+	BuildDate               string            `json:"buildDate"`
+	GoVersion               string            `json:"goVersion"`
+	Compiler                string            `json:"compiler"`
+	Platform                string            `json:"platform"`
+	MinCompatibleAPIVersion map[string]string `json:"minCompatibleAPIVersion"`
+	MaxCompatibleAPIVersion map[string]string `json:"maxCompatibleAPIVersion"`
+}
+
+// GetInfo returns the Info describing the running binary.
+func GetInfo() Info {
+	return Info{
+		Version:                 Version,
+		GitSHA:                  GitSHA,
+		GitTreeState:            GitTreeState,
+		BuildDate:               BuildDate,
+		GoVersion:               GoVersion,
+		Compiler:                Compiler,
+		Platform:                fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+		MinCompatibleAPIVersion: MinCompatibleAPIVersion,
+		MaxCompatibleAPIVersion: MaxCompatibleAPIVersion,
+	}
+}
+
 func GetVersion() string {
 	return Version
 }
@@ -55,4 +113,58 @@ func GetFullVersion() string {
 // linux) and GOARCH is the the running program's architecture target (e.g. amd64).
 func GetFullVersionWithRuntimeInfo() string {
 	return fmt.Sprintf("%s %s/%s", GetFullVersion(), runtime.GOOS, runtime.GOARCH)
-}
\ No newline at end of file
+}
+
+// controlplaneGroup is the one controlplane API group agent<->controller RPCs and antctl
+// negotiate a version for today.
+const controlplaneGroup = "controlplane.antrea.tanzu.vmware.com"
+
+// Negotiate picks the controlplane API version both this binary and remote support: the newest
+// version that falls within both [MinCompatibleAPIVersion, MaxCompatibleAPIVersion] ranges for
+// controlplaneGroup. Callers (agent reporting to controller, antctl talking to the aggregated API
+// server) use the returned version instead of hard-coding a single controlplane package like
+// cpv1beta.
+//
+// It returns an error if either side doesn't advertise a range for controlplaneGroup, or if the
+// two ranges don't overlap at all (e.g. remote's max is older than this binary's min).
+func Negotiate(remote Info) (chosen string, err error) {
+	localMin, ok := MinCompatibleAPIVersion[controlplaneGroup]
+	if !ok {
+		return "", fmt.Errorf("local binary does not support group %q", controlplaneGroup)
+	}
+	localMax, ok := MaxCompatibleAPIVersion[controlplaneGroup]
+	if !ok {
+		return "", fmt.Errorf("local binary does not support group %q", controlplaneGroup)
+	}
+	remoteMin, ok := remote.MinCompatibleAPIVersion[controlplaneGroup]
+	if !ok {
+		return "", fmt.Errorf("remote %s does not advertise a minimum version for group %q", remote.Version, controlplaneGroup)
+	}
+	remoteMax, ok := remote.MaxCompatibleAPIVersion[controlplaneGroup]
+	if !ok {
+		return "", fmt.Errorf("remote %s does not advertise a maximum version for group %q", remote.Version, controlplaneGroup)
+	}
+
+	// The overlap of [localMin, localMax] and [remoteMin, remoteMax] is
+	// [max(localMin, remoteMin), min(localMax, remoteMax)].
+	effectiveMin := localMin
+	if apiVersionLess(effectiveMin, remoteMin) {
+		effectiveMin = remoteMin
+	}
+	effectiveMax := localMax
+	if apiVersionLess(remoteMax, effectiveMax) {
+		effectiveMax = remoteMax
+	}
+	if apiVersionLess(effectiveMax, effectiveMin) {
+		return "", fmt.Errorf("no mutually-supported %s version: local supports [%s, %s], remote %s supports [%s, %s]",
+			controlplaneGroup, localMin, localMax, remote.Version, remoteMin, remoteMax)
+	}
+	// Prefer the newest version both sides can speak.
+	return effectiveMax, nil
+}
+
+// apiVersionLess reports whether a is an older version than b, e.g. "v1beta1" < "v1beta2". It only
+// needs to order the handful of "v<major>beta<minor>"/"v<major>"-style versions Antrea uses.
+func apiVersionLess(a, b string) bool {
+	return kversion.CompareKubeAwareVersionStrings(a, b) < 0
+}